@@ -0,0 +1,35 @@
+// Package audit records every security- and data-relevant StudentManager
+// call as a structured event, so operators have a forensic trail of logins,
+// registrations and data changes beyond what failedLoginAttempts alone can
+// show.
+package audit
+
+import "time"
+
+// Action identifies which StudentManager operation an Event records.
+type Action string
+
+const (
+	ActionLogin          Action = "login"
+	ActionRegister       Action = "register"
+	ActionModifyStudent  Action = "modify_student"
+	ActionImportStudents Action = "import_students"
+)
+
+// Outcome is how the recorded action concluded.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+	OutcomeLockout Outcome = "lockout"
+)
+
+// Event is a single structured audit record.
+type Event struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Actor     string            `json:"actor"`
+	Action    Action            `json:"action"`
+	Outcome   Outcome           `json:"outcome"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
@@ -0,0 +1,56 @@
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// Logger records Events as newline-delimited JSON to an underlying writer
+// (typically a *RotatingWriter) and fans them out to live subscribers.
+type Logger struct {
+	mu   sync.Mutex
+	w    io.Writer
+	subs []chan Event
+}
+
+// NewLogger returns a Logger that writes to w.
+func NewLogger(w io.Writer) *Logger {
+	return &Logger{w: w}
+}
+
+// Log records event: it is written to the underlying writer and pushed to
+// every subscriber channel. Log never blocks on a slow subscriber; the
+// event is dropped for that subscriber instead.
+func (l *Logger) Log(event Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	raw = append(raw, '\n')
+	if _, err := l.w.Write(raw); err != nil {
+		return err
+	}
+
+	for _, sub := range l.subs {
+		select {
+		case sub <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a channel that receives every future Event. The
+// channel is buffered; a slow reader misses events rather than blocking Log.
+func (l *Logger) Subscribe() <-chan Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	ch := make(chan Event, 32)
+	l.subs = append(l.subs, ch)
+	return ch
+}
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// RotatingWriter is an io.Writer that rolls over to a new file once the
+// current one reaches maxBytes, keeping at most maxGenerations old files
+// around (path.1 is the most recent rotation, path.2 the one before that,
+// and so on).
+type RotatingWriter struct {
+	mu             sync.Mutex
+	path           string
+	maxBytes       int64
+	maxGenerations int
+	file           *os.File
+	size           int64
+}
+
+// NewRotatingWriter opens (or creates) path for appending, rotating it
+// according to maxBytes and maxGenerations.
+func NewRotatingWriter(path string, maxBytes int64, maxGenerations int) (*RotatingWriter, error) {
+	w := &RotatingWriter{path: path, maxBytes: maxBytes, maxGenerations: maxGenerations}
+	if err := w.openCurrent(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *RotatingWriter) openCurrent() error {
+	f, err := os.OpenFile(w.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("audit: open %s: %w", w.path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("audit: stat %s: %w", w.path, err)
+	}
+	w.file = f
+	w.size = info.Size()
+	return nil
+}
+
+// Write appends p, rotating first if it would push the current file past
+// maxBytes.
+func (w *RotatingWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.size+int64(len(p)) > w.maxBytes {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+func (w *RotatingWriter) rotate() error {
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	for gen := w.maxGenerations - 1; gen >= 1; gen-- {
+		oldPath := w.generationPath(gen)
+		newPath := w.generationPath(gen + 1)
+		if _, err := os.Stat(oldPath); err == nil {
+			os.Rename(oldPath, newPath)
+		}
+	}
+	if err := os.Rename(w.path, w.generationPath(1)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return w.openCurrent()
+}
+
+func (w *RotatingWriter) generationPath(gen int) string {
+	return fmt.Sprintf("%s.%d", w.path, gen)
+}
+
+// Close closes the currently open file.
+func (w *RotatingWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
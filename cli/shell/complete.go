@@ -0,0 +1,40 @@
+package shell
+
+import "strings"
+
+// completeCandidates returns the completions for the word currently being
+// typed in line: a verb if it is the first word, a study program code if
+// the verb expects one as its last argument.
+func completeCandidates(line string) []string {
+	fields := strings.Fields(line)
+	trailingSpace := strings.HasSuffix(line, " ")
+
+	if len(fields) == 0 || (len(fields) == 1 && !trailingSpace) {
+		prefix := ""
+		if len(fields) == 1 {
+			prefix = fields[0]
+		}
+		return matching(verbs, prefix)
+	}
+
+	verb := fields[0]
+	if verb != "register" && verb != "modify" {
+		return nil
+	}
+
+	prefix := ""
+	if !trailingSpace {
+		prefix = fields[len(fields)-1]
+	}
+	return matching(studyProgramCodes, prefix)
+}
+
+func matching(candidates []string, prefix string) []string {
+	var out []string
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
@@ -0,0 +1,158 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// lineEditor renders a single editable line with arrow-key history recall
+// and tab completion by reading raw keystrokes, rather than a whole
+// buffered line at a time, so it can react to each key as it arrives.
+type lineEditor struct {
+	in       io.Reader
+	out      io.Writer
+	history  *History
+	complete func(string) []string
+}
+
+func newLineEditor(in io.Reader, out io.Writer, history *History, complete func(string) []string) *lineEditor {
+	return &lineEditor{in: in, out: out, history: history, complete: complete}
+}
+
+const (
+	keyBackspace = 0x7f
+	keyCtrlD     = 0x04
+)
+
+// ReadLine prints prompt and reads one line, supporting Up/Down to recall
+// history and Tab to complete the current word. The terminal is put into
+// raw mode for the duration of the read so keys can be handled one at a
+// time; on platforms or streams where that isn't possible it falls back to
+// a plain buffered read (still correct, just without live editing).
+func (e *lineEditor) ReadLine(prompt string) (string, error) {
+	restore, err := enableRawMode(e.in)
+	if err != nil {
+		return readLineBuffered(e.in, e.out, prompt)
+	}
+	defer restore()
+
+	fmt.Fprint(e.out, prompt)
+	var buf []rune
+	historyOffset := -1
+	var stashed string
+
+	for {
+		r, err := readRune(e.in)
+		if err != nil {
+			return "", err
+		}
+
+		switch {
+		case r == '\r' || r == '\n':
+			fmt.Fprintln(e.out)
+			return string(buf), nil
+		case r == keyCtrlD && len(buf) == 0:
+			return "", io.EOF
+		case r == keyBackspace || r == '\b':
+			if len(buf) > 0 {
+				buf = buf[:len(buf)-1]
+				redraw(e.out, prompt, buf)
+			}
+		case r == '\t':
+			candidates := e.complete(string(buf))
+			switch len(candidates) {
+			case 1:
+				buf = applyCompletion(buf, candidates[0])
+			default:
+				if len(candidates) > 1 {
+					fmt.Fprintln(e.out)
+					fmt.Fprintln(e.out, strings.Join(candidates, "  "))
+				}
+			}
+			redraw(e.out, prompt, buf)
+		case r == 0x1b: // ESC: possibly an arrow-key sequence (ESC [ A/B/C/D)
+			seq, err := readEscapeSequence(e.in)
+			if err != nil {
+				continue
+			}
+			switch seq {
+			case "[A": // Up: older history
+				if historyOffset == -1 {
+					stashed = string(buf)
+				}
+				if line, ok := e.history.At(historyOffset + 1); ok {
+					historyOffset++
+					buf = []rune(line)
+					redraw(e.out, prompt, buf)
+				}
+			case "[B": // Down: newer history, back to the in-progress line
+				if historyOffset < 0 {
+					continue
+				}
+				if historyOffset == 0 {
+					historyOffset = -1
+					buf = []rune(stashed)
+				} else {
+					historyOffset--
+					line, _ := e.history.At(historyOffset)
+					buf = []rune(line)
+				}
+				redraw(e.out, prompt, buf)
+			}
+		default:
+			buf = append(buf, r)
+			redraw(e.out, prompt, buf)
+		}
+	}
+}
+
+func redraw(out io.Writer, prompt string, buf []rune) {
+	fmt.Fprintf(out, "\r\x1b[K%s%s", prompt, string(buf))
+}
+
+// applyCompletion replaces the word currently being typed with candidate.
+func applyCompletion(buf []rune, candidate string) []rune {
+	line := string(buf)
+	cut := strings.LastIndexByte(line, ' ') + 1
+	return []rune(line[:cut] + candidate)
+}
+
+// readLineBuffered is the non-interactive fallback: a plain line read with
+// no history or completion, used when stdin isn't a real terminal.
+func readLineBuffered(in io.Reader, out io.Writer, prompt string) (string, error) {
+	fmt.Fprint(out, prompt)
+	var buf []byte
+	b := make([]byte, 1)
+	for {
+		n, err := in.Read(b)
+		if n == 0 || err != nil {
+			if len(buf) == 0 {
+				return "", io.EOF
+			}
+			return string(buf), nil
+		}
+		if b[0] == '\n' {
+			return string(buf), nil
+		}
+		if b[0] != '\r' {
+			buf = append(buf, b[0])
+		}
+	}
+}
+
+func readRune(in io.Reader) (rune, error) {
+	b := make([]byte, 1)
+	if _, err := in.Read(b); err != nil {
+		return 0, err
+	}
+	return rune(b[0]), nil
+}
+
+func readEscapeSequence(in io.Reader) (string, error) {
+	b := make([]byte, 2)
+	if _, err := io.ReadFull(in, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
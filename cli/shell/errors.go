@@ -0,0 +1,7 @@
+package shell
+
+import "errors"
+
+// errNotATTY is returned by enableRawMode when the input stream isn't a
+// real terminal, or raw mode isn't supported on the current platform.
+var errNotATTY = errors.New("shell: not a terminal")
@@ -0,0 +1,69 @@
+package shell
+
+import (
+	"os"
+	"strings"
+)
+
+// maxHistory caps how many lines are kept, to stop the history file from
+// growing without bound across a long-lived session.
+const maxHistory = 500
+
+// History is an in-memory, append-only command history, persisted to a
+// file so it survives across shell invocations.
+type History struct {
+	path    string
+	entries []string
+}
+
+// LoadHistory reads path if it exists and returns a History seeded from it.
+// A missing or unreadable file just starts empty; history is a convenience,
+// not something worth failing startup over.
+func LoadHistory(path string) *History {
+	h := &History{path: path}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return h
+	}
+	for _, line := range strings.Split(string(raw), "\n") {
+		if line != "" {
+			h.entries = append(h.entries, line)
+		}
+	}
+	return h
+}
+
+// Add appends line to the history and persists it, ignoring consecutive
+// duplicates the way most shells do.
+func (h *History) Add(line string) {
+	if len(h.entries) > 0 && h.entries[len(h.entries)-1] == line {
+		return
+	}
+	h.entries = append(h.entries, line)
+	if len(h.entries) > maxHistory {
+		h.entries = h.entries[len(h.entries)-maxHistory:]
+	}
+	h.save()
+}
+
+// At returns the entry at offset from the end (0 is the most recent entry)
+// and whether that offset is in range.
+func (h *History) At(offsetFromEnd int) (string, bool) {
+	idx := len(h.entries) - 1 - offsetFromEnd
+	if idx < 0 || idx >= len(h.entries) {
+		return "", false
+	}
+	return h.entries[idx], true
+}
+
+// Len reports how many entries are currently recorded.
+func (h *History) Len() int {
+	return len(h.entries)
+}
+
+func (h *History) save() {
+	if h.path == "" {
+		return
+	}
+	_ = os.WriteFile(h.path, []byte(strings.Join(h.entries, "\n")+"\n"), 0o644)
+}
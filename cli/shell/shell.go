@@ -0,0 +1,190 @@
+// Package shell implements an interactive command shell for the student
+// portal, with arrow-key history and tab completion, as an alternative to
+// the numeric menu in main. main falls back to that menu whenever stdin or
+// stdout is not a terminal (piped input, CI, ...), since none of the line-editing
+// below means anything without a real TTY.
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"a21hc3NpZ25tZW50/model"
+	"a21hc3NpZ25tZW50/studentmgr"
+)
+
+// Manager is the subset of StudentManager the shell drives.
+type Manager interface {
+	Login(id, name string) (string, error)
+	Register(id, name, studyProgram string) (string, error)
+	GetStudyProgram(code string) (string, error)
+	ModifyStudent(name string, fn model.StudentModifier) (string, error)
+	ImportStudents(filenames []string) error
+	SubmitAssignments(numAssignments int)
+	GetStudents() []model.Student
+}
+
+// progressImporter is implemented by Managers that support
+// ImportStudentsWithProgress (currently only studentmgr.InMemoryStudentManager);
+// the "import" command falls back to the plain ImportStudents otherwise. It
+// mirrors the identically-named interface in main.go's runMenu.
+type progressImporter interface {
+	ImportStudentsWithProgress(ctx context.Context, filenames []string, opts studentmgr.ImportOptions) (*studentmgr.ImportReport, error)
+}
+
+// verbs are the commands the shell understands and offers for completion.
+var verbs = []string{"login", "register", "modify", "import", "submit", "students", "help", "exit"}
+
+// studyProgramCodes are offered for completion wherever a command expects a
+// study program argument.
+var studyProgramCodes = []string{"TI", "TK", "SI", "MI"}
+
+// Shell is a readline-style front end for a Manager.
+type Shell struct {
+	manager    Manager
+	in         io.Reader
+	out        io.Writer
+	history    *History
+	changeProg func(string) model.StudentModifier
+}
+
+// New returns a Shell that reads raw keystrokes from in and writes to out.
+// changeProgram builds the StudentModifier used by the "modify" command; it
+// mirrors InMemoryStudentManager.ChangeStudyProgram since that method lives
+// on the concrete manager type and isn't part of Manager.
+func New(manager Manager, in io.Reader, out io.Writer, historyPath string, changeProgram func(string) model.StudentModifier) *Shell {
+	return &Shell{
+		manager:    manager,
+		in:         in,
+		out:        out,
+		history:    LoadHistory(historyPath),
+		changeProg: changeProgram,
+	}
+}
+
+// Run starts the read-eval-print loop. It returns when the user types
+// "exit" or the input stream is closed.
+func (s *Shell) Run() error {
+	editor := newLineEditor(s.in, s.out, s.history, completeCandidates)
+
+	fmt.Fprintln(s.out, "Student portal shell. Type 'help' for commands, 'exit' to quit.")
+	for {
+		line, err := editor.ReadLine("> ")
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		s.history.Add(line)
+
+		if line == "exit" {
+			return nil
+		}
+		s.dispatch(line)
+		s.suggestNext(line)
+	}
+}
+
+func (s *Shell) dispatch(line string) {
+	fields := strings.Fields(line)
+	verb := fields[0]
+	args := fields[1:]
+
+	switch verb {
+	case "help":
+		fmt.Fprintln(s.out, "Commands: login <id> <name> | register <id> <name> <program> | modify <name> <program> | import <file...> | submit <n> | students | exit")
+	case "students":
+		for _, student := range s.manager.GetStudents() {
+			fmt.Fprintf(s.out, "%s\t%s\t%s\n", student.ID, student.Name, student.StudyProgram)
+		}
+	case "login":
+		if len(args) < 2 {
+			fmt.Fprintln(s.out, "usage: login <id> <name>")
+			return
+		}
+		msg, err := s.manager.Login(args[0], strings.Join(args[1:], " "))
+		s.printResult(msg, err)
+	case "register":
+		if len(args) < 3 {
+			fmt.Fprintln(s.out, "usage: register <id> <name> <program>")
+			return
+		}
+		program := args[len(args)-1]
+		name := strings.Join(args[1:len(args)-1], " ")
+		msg, err := s.manager.Register(args[0], name, program)
+		s.printResult(msg, err)
+	case "modify":
+		if len(args) < 2 {
+			fmt.Fprintln(s.out, "usage: modify <name> <program>")
+			return
+		}
+		program := args[len(args)-1]
+		name := strings.Join(args[:len(args)-1], " ")
+		msg, err := s.manager.ModifyStudent(name, s.changeProg(program))
+		s.printResult(msg, err)
+	case "import":
+		if len(args) == 0 {
+			fmt.Fprintln(s.out, "usage: import <file...>")
+			return
+		}
+		if pi, ok := s.manager.(progressImporter); ok {
+			opts := studentmgr.ImportOptions{SkipDuplicates: true, ContinueOnError: true, Concurrency: 4}
+			report, err := pi.ImportStudentsWithProgress(context.Background(), args, opts)
+			if err != nil {
+				fmt.Fprintln(s.out, "Error importing students:", err)
+				return
+			}
+			fmt.Fprintf(s.out, "Import selesai: %d baris, %d berhasil, %d duplikat, %d gagal\n",
+				report.TotalRows, report.Inserted, report.Duplicates, report.Failed)
+			return
+		}
+		if err := s.manager.ImportStudents(args); err != nil {
+			fmt.Fprintln(s.out, "Error importing students:", err)
+			return
+		}
+		fmt.Fprintln(s.out, "Students imported successfully.")
+	case "submit":
+		if len(args) != 1 {
+			fmt.Fprintln(s.out, "usage: submit <n>")
+			return
+		}
+		var n int
+		if _, err := fmt.Sscanf(args[0], "%d", &n); err != nil {
+			fmt.Fprintln(s.out, "invalid number:", args[0])
+			return
+		}
+		s.manager.SubmitAssignments(n)
+	default:
+		fmt.Fprintf(s.out, "unknown command %q, type 'help' for a list\n", verb)
+	}
+}
+
+func (s *Shell) printResult(msg string, err error) {
+	if err != nil {
+		fmt.Fprintln(s.out, err)
+		return
+	}
+	fmt.Fprintln(s.out, msg)
+}
+
+// suggestNext prints a short, context-aware hint for what the user is
+// likely to type next, based on the command they just ran.
+func (s *Shell) suggestNext(line string) {
+	verb := strings.Fields(line)[0]
+	switch verb {
+	case "register":
+		fmt.Fprintln(s.out, "next: try 'login <id> <name>' or 'students'")
+	case "login":
+		fmt.Fprintln(s.out, "next: try 'submit <n>' or 'modify <name> <program>'")
+	case "import":
+		fmt.Fprintln(s.out, "next: try 'students' to review the imported rows")
+	}
+}
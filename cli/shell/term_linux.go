@@ -0,0 +1,63 @@
+//go:build linux
+
+package shell
+
+import (
+	"io"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// termios follows glibc's struct termios layout, whose leading fields match
+// the kernel's struct termios from <asm-generic/termbits.h>; that is enough
+// to toggle canonical mode and echo.
+type termios struct {
+	Iflag, Oflag, Cflag, Lflag uint32
+	Line                       byte
+	Cc                         [32]byte
+	Ispeed, Ospeed             uint32
+}
+
+// TCGETS/TCSETS and the ICANON/ECHO bits come from asm-generic/ioctls.h and
+// termbits.h; they're stable ABI on linux/amd64 and linux/arm64.
+const (
+	tcgets = 0x5401
+	tcsets = 0x5402
+	icanon = 0x2
+	echo   = 0x8
+)
+
+// enableRawMode puts in (when it is the controlling terminal) into raw
+// mode - no line buffering, no local echo - so the shell can read and react
+// to individual keystrokes. The returned func restores the original mode.
+func enableRawMode(in io.Reader) (func(), error) {
+	f, ok := in.(*os.File)
+	if !ok {
+		return nil, errNotATTY
+	}
+	fd := f.Fd()
+
+	var oldState termios
+	if err := ioctl(fd, tcgets, unsafe.Pointer(&oldState)); err != nil {
+		return nil, errNotATTY
+	}
+
+	newState := oldState
+	newState.Lflag &^= icanon | echo
+	if err := ioctl(fd, tcsets, unsafe.Pointer(&newState)); err != nil {
+		return nil, errNotATTY
+	}
+
+	return func() {
+		_ = ioctl(fd, tcsets, unsafe.Pointer(&oldState))
+	}, nil
+}
+
+func ioctl(fd uintptr, request uintptr, arg unsafe.Pointer) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, request, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
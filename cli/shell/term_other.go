@@ -0,0 +1,11 @@
+//go:build !linux
+
+package shell
+
+import "io"
+
+// enableRawMode has no implementation outside linux; callers fall back to
+// readLineBuffered instead.
+func enableRawMode(in io.Reader) (func(), error) {
+	return nil, errNotATTY
+}
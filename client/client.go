@@ -0,0 +1,103 @@
+// Package client is a thin Go wrapper around the StudentManager gRPC
+// service, for programs that want to talk to studentd without hand-rolling
+// protobuf calls.
+package client
+
+import (
+	"context"
+	"io"
+
+	pb "a21hc3NpZ25tZW50/proto/studentmanagerpb"
+
+	"google.golang.org/grpc"
+)
+
+// Client talks to a studentd instance over gRPC.
+type Client struct {
+	conn *grpc.ClientConn
+	rpc  pb.StudentManagerClient
+}
+
+// Dial connects to a studentd server listening at addr.
+func Dial(addr string, opts ...grpc.DialOption) (*Client, error) {
+	conn, err := grpc.NewClient(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{conn: conn, rpc: pb.NewStudentManagerClient(conn)}, nil
+}
+
+// Close releases the underlying connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) Login(ctx context.Context, id, name string) (string, error) {
+	resp, err := c.rpc.Login(ctx, &pb.LoginRequest{Id: id, Name: name})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetMessage(), nil
+}
+
+func (c *Client) Register(ctx context.Context, id, name, studyProgram string) (string, error) {
+	resp, err := c.rpc.Register(ctx, &pb.RegisterRequest{Id: id, Name: name, StudyProgram: studyProgram})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetMessage(), nil
+}
+
+func (c *Client) GetStudyProgram(ctx context.Context, code string) (string, error) {
+	resp, err := c.rpc.GetStudyProgram(ctx, &pb.GetStudyProgramRequest{Code: code})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetName(), nil
+}
+
+func (c *Client) ModifyStudent(ctx context.Context, name, newStudyProgram string) (string, error) {
+	resp, err := c.rpc.ModifyStudent(ctx, &pb.ModifyStudentRequest{Name: name, NewStudyProgram: newStudyProgram})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetMessage(), nil
+}
+
+// ImportStudents streams students to the server and returns how many rows
+// were imported.
+func (c *Client) ImportStudents(ctx context.Context, students []pb.Student) (int, error) {
+	stream, err := c.rpc.ImportStudents(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for i := range students {
+		if err := stream.Send(&pb.ImportStudentsRequest{Student: &students[i]}); err != nil {
+			return 0, err
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return 0, err
+	}
+	return int(resp.GetImported()), nil
+}
+
+// SubmitAssignments submits numAssignments and invokes onProgress for every
+// event the server streams back, until the server closes the stream.
+func (c *Client) SubmitAssignments(ctx context.Context, numAssignments int, onProgress func(*pb.SubmitAssignmentsProgress)) error {
+	stream, err := c.rpc.SubmitAssignments(ctx, &pb.SubmitAssignmentsRequest{NumAssignments: int32(numAssignments)})
+	if err != nil {
+		return err
+	}
+	for {
+		progress, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		onProgress(progress)
+	}
+}
@@ -0,0 +1,36 @@
+// Command studentd serves the StudentManager API over gRPC.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+
+	grpcserver "a21hc3NpZ25tZW50/server/grpc"
+	"a21hc3NpZ25tZW50/studentmgr"
+
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":50051", "address to listen on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("studentd: listen on %s: %v", *addr, err)
+	}
+
+	// The same manager the CLI uses, so studentd gets its per-ID login
+	// backoff for free instead of a parallel reimplementation. studentd
+	// enables neither audit logging nor the global login rate limiter.
+	manager := studentmgr.NewInMemoryStudentManager()
+
+	grpcServer := grpc.NewServer()
+	grpcserver.Register(grpcServer, grpcserver.NewServer(manager))
+
+	log.Printf("studentd: listening on %s", *addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("studentd: serve: %v", err)
+	}
+}
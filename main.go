@@ -2,263 +2,122 @@ package main
 
 import (
 	"bufio"
-	"encoding/csv"
+	"context"
+	"flag"
 	"fmt"
-	"io"
 	"os"
 	"strings"
-	"sync"
-	"time"
 
-	"a21hc3NpZ25tZW50/model"
+	"a21hc3NpZ25tZW50/audit"
+	"a21hc3NpZ25tZW50/store"
+	"a21hc3NpZ25tZW50/studentmgr"
 )
 
-type StudentManager interface {
-	Login(id string, name string) (string, error)
-	Register(id string, name string, studyProgram string) (string, error)
-	GetStudyProgram(code string) (string, error)
-	ModifyStudent(name string, fn model.StudentModifier) (string, error)
-	ImportStudents(filenames []string) error
-	SubmitAssignments(numAssignments int)
-	GetStudents() []model.Student
+// progressImporter is implemented by StudentManagers that support
+// ImportStudentsWithProgress (currently only studentmgr.InMemoryStudentManager);
+// the menu falls back to the plain ImportStudents otherwise.
+type progressImporter interface {
+	ImportStudentsWithProgress(ctx context.Context, filenames []string, opts studentmgr.ImportOptions) (*studentmgr.ImportReport, error)
 }
 
-type InMemoryStudentManager struct {
-	sync.Mutex
-	students             []model.Student
-	studentStudyPrograms map[string]string
-	failedLoginAttempts  map[string]int
+// auditEnabler is implemented by StudentManagers that support EnableAudit
+// (studentmgr.InMemoryStudentManager and studentmgr.PersistentStudentManager).
+type auditEnabler interface {
+	EnableAudit(logger *audit.Logger)
 }
 
-func NewInMemoryStudentManager() *InMemoryStudentManager {
-	return &InMemoryStudentManager{
-		students: []model.Student{
-			{ID: "A12345", Name: "Aditira", StudyProgram: "TI"},
-			{ID: "B21313", Name: "Dito", StudyProgram: "TK"},
-			{ID: "A34555", Name: "Afis", StudyProgram: "MI"},
-		},
-		studentStudyPrograms: map[string]string{
-			"TI": "Teknik Informatika",
-			"TK": "Teknik Komputer",
-			"SI": "Sistem Informasi",
-			"MI": "Manajemen Informasi",
-		},
-		failedLoginAttempts: make(map[string]int),
-	}
-}
-
-func (sm *InMemoryStudentManager) GetStudents() []model.Student {
-	sm.Lock()
-	defer sm.Unlock()
-	return sm.students
+// globalRateLimitable is implemented by StudentManagers that support
+// SetGlobalLoginLimiter (studentmgr.InMemoryStudentManager and
+// studentmgr.PersistentStudentManager).
+type globalRateLimitable interface {
+	SetGlobalLoginLimiter(limiter *studentmgr.TokenBucket)
 }
 
-func ReadStudentsFromCSV(filename string) ([]model.Student, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	reader := csv.NewReader(file)
-	reader.FieldsPerRecord = 3 // ID, Name, StudyProgram
+// auditRotationBytes and auditRotationGenerations bound the audit log
+// enabled by --audit-log: once the current file reaches auditRotationBytes
+// it's rolled over, keeping at most auditRotationGenerations old files.
+const (
+	auditRotationBytes       = 10 * 1024 * 1024
+	auditRotationGenerations = 5
+)
 
-	var students []model.Student
-	for {
-		record, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
+func main() {
+	jsonOutput := flag.Bool("json", false, "emit a machine-readable JSON import report")
+	storePath := flag.String("store", "", "path to a JSON file for persistent storage; empty keeps everything in memory")
+	auditLogPath := flag.String("audit-log", "", "path to a rotating JSON audit log of login/register/modify events; empty disables it")
+	globalRateLimit := flag.Float64("global-login-rate-limit", 0, "max sustained login attempts per second across all IDs, as a token-bucket refill rate; 0 disables it")
+	globalRateBurst := flag.Float64("global-login-rate-burst", 10, "token-bucket capacity for --global-login-rate-limit, i.e. how many attempts may burst above the sustained rate")
+	flag.Parse()
+
+	var manager studentmgr.StudentManager
+	if *storePath != "" {
+		s, err := store.NewFileStore(*storePath)
 		if err != nil {
-			return nil, err
-		}
-
-		if len(record) != 3 {
-			return nil, fmt.Errorf("record is incomplete: %v", record)
+			fmt.Fprintln(os.Stderr, "store error:", err)
+			os.Exit(1)
 		}
+		defer s.Close()
 
-		student := model.Student{
-			ID:           record[0],
-			Name:         record[1],
-			StudyProgram: record[2],
+		persistent, err := studentmgr.NewPersistentStudentManager(s)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "store error:", err)
+			os.Exit(1)
 		}
-		students = append(students, student)
+		manager = persistent
+	} else {
+		manager = studentmgr.NewInMemoryStudentManager()
 	}
-	return students, nil
-}
-
-func (sm *InMemoryStudentManager) Login(id string, name string) (string, error) {
-	sm.Lock()
-	defer sm.Unlock()
 
-	if id == "" {
-		return "", fmt.Errorf("Login gagal: ID tidak boleh kosong")
-	}
-	if name == "" {
-		return "", fmt.Errorf("Login gagal: Nama tidak boleh kosong")
-	}
-
-	if attempts, exists := sm.failedLoginAttempts[id]; exists && attempts >= 3 {
-		return "", fmt.Errorf("Login gagal: Batas maksimum login terlampaui")
-	}
-
-	for _, student := range sm.students {
-		if student.ID == id {
-			if student.Name == name {
-				sm.failedLoginAttempts[id] = 0 // Reset on success
-				return fmt.Sprintf("Login berhasil: Selamat datang %s! Kamu terdaftar di program studi: %s", student.Name, sm.studentStudyPrograms[student.StudyProgram]), nil
-			}
-			sm.failedLoginAttempts[id]++ // Increment on wrong name
-			return "", fmt.Errorf("Login gagal: data mahasiswa tidak ditemukan")
+	if *auditLogPath != "" {
+		w, err := audit.NewRotatingWriter(*auditLogPath, auditRotationBytes, auditRotationGenerations)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "audit log error:", err)
+			os.Exit(1)
 		}
-	}
-
-	sm.failedLoginAttempts[id]++ // Increment on invalid ID
-	return "", fmt.Errorf("Login gagal: data mahasiswa tidak ditemukan")
-}
+		defer w.Close()
 
-func (sm *InMemoryStudentManager) Register(id string, name string, studyProgram string) (string, error) {
-	if id == "" || name == "" || studyProgram == "" {
-		return "", fmt.Errorf("ID, Name or StudyProgram is undefined!")
-	}
-
-	if _, exists := sm.studentStudyPrograms[studyProgram]; !exists {
-		return "", fmt.Errorf("Study program %s is not found", studyProgram)
-	}
-
-	for _, student := range sm.students {
-		if student.ID == id {
-			return "", fmt.Errorf("Registrasi gagal: id sudah digunakan")
+		if enabler, ok := manager.(auditEnabler); ok {
+			enabler.EnableAudit(audit.NewLogger(w))
+		} else {
+			fmt.Fprintln(os.Stderr, "--audit-log ignored: this manager does not support audit logging")
 		}
 	}
 
-	newStudent := model.Student{
-		ID:           id,
-		Name:         name,
-		StudyProgram: studyProgram,
-	}
-	sm.students = append(sm.students, newStudent)
-	return fmt.Sprintf("Registrasi berhasil: %s (%s)", newStudent.Name, newStudent.StudyProgram), nil
-}
-
-func (sm *InMemoryStudentManager) GetStudyProgram(code string) (string, error) {
-	if program, exists := sm.studentStudyPrograms[code]; exists {
-		return program, nil
-	}
-	return "", fmt.Errorf("program studi tidak ditemukan")
-}
-
-func (sm *InMemoryStudentManager) ModifyStudent(name string, fn model.StudentModifier) (string, error) {
-	sm.Lock()
-	defer sm.Unlock()
-
-	for i, student := range sm.students {
-		if student.Name == name {
-			if err := fn(&sm.students[i]); err != nil {
-				return "", err
-			}
-			return "Program studi mahasiswa berhasil diubah.", nil
-		}
-	}
-	return "", fmt.Errorf("Mahasiswa tidak ditemukan")
-}
-
-func (sm *InMemoryStudentManager) ChangeStudyProgram(programStudi string) model.StudentModifier {
-	return func(s *model.Student) error {
-		if _, exists := sm.studentStudyPrograms[programStudi]; !exists {
-			return fmt.Errorf("program studi tidak valid")
+	if *globalRateLimit > 0 {
+		if limitable, ok := manager.(globalRateLimitable); ok {
+			limitable.SetGlobalLoginLimiter(studentmgr.NewTokenBucket(*globalRateBurst, *globalRateLimit))
+		} else {
+			fmt.Fprintln(os.Stderr, "--global-login-rate-limit ignored: this manager does not support a global login limiter")
 		}
-		s.StudyProgram = programStudi
-		return nil
 	}
-}
-
-func (sm *InMemoryStudentManager) ImportStudents(filenames []string) error {
-	var wg sync.WaitGroup
-	studentsChan := make(chan []model.Student)
-
-	for _, filename := range filenames {
-		wg.Add(1)
-		go func(f string) {
-			defer wg.Done()
-			students, err := ReadStudentsFromCSV(f)
-			if err == nil {
-				studentsChan <- students
-			}
-		}(filename)
-	}
-
-	go func() {
-		wg.Wait()
-		close(studentsChan)
-	}()
 
-	for students := range studentsChan {
-		for _, student := range students {
-			_, err := sm.Register(student.ID, student.Name, student.StudyProgram)
-			if err != nil {
-				return err
-			}
+	if isTerminal(os.Stdin) && isTerminal(os.Stdout) {
+		if err := runShell(manager); err != nil {
+			fmt.Fprintln(os.Stderr, "shell error:", err)
+			os.Exit(1)
 		}
+		return
 	}
-	return nil
+	runMenu(manager, jsonOutput)
 }
 
-func (sm *InMemoryStudentManager) SubmitAssignmentLongProcess() {
-	// Simulate a time-consuming task to match test expectations
-	time.Sleep(40 * time.Millisecond)
-}
-
-func (sm *InMemoryStudentManager) SubmitAssignments(numAssignments int) {
-	start := time.Now()
-
-	jobs := make(chan int, numAssignments)
-	results := make(chan string)
-	var wg sync.WaitGroup
-
-	workerCount := 4 // Set worker count to 3 to match test expectations
-	for w := 1; w <= workerCount; w++ {
-		wg.Add(1)
-		go func(worker int) {
-			defer wg.Done()
-			for assignment := range jobs {
-				sm.SubmitAssignmentLongProcess() // Simulated processing
-				results <- fmt.Sprintf("Worker %d: Finished assignment %d", worker, assignment)
-			}
-		}(w)
-	}
-
-	for i := 1; i <= numAssignments; i++ {
-		jobs <- i
-	}
-	close(jobs)
-
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
-
-	for result := range results {
-		fmt.Println(result)
-	}
-
-	elapsed := time.Since(start)
-	fmt.Printf("Submitting %d assignments took %s\n", numAssignments, elapsed)
-
-	// Ensure execution time does not exceed 200ms but is more than 110ms
-	if elapsed > 150*time.Millisecond {
-		fmt.Println("Warning: Submission took longer than expected!")
-	} else if elapsed < 110*time.Millisecond {
-		fmt.Println("Warning: Submission was too fast, expected more workload!")
+// isTerminal reports whether f looks like an interactive terminal rather
+// than a pipe or a file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
 	}
+	return info.Mode()&os.ModeCharDevice != 0
 }
 
-func main() {
-	manager := NewInMemoryStudentManager()
-
+// runMenu is the numeric-menu fallback used when stdin or stdout isn't a
+// terminal (piped input, CI, ...), where the interactive shell's line editing and
+// history wouldn't mean anything.
+func runMenu(manager studentmgr.StudentManager, jsonOutput *bool) {
 	reader := bufio.NewReader(os.Stdin)
 	for {
-		fmt.Print("[H[2J")
+		fmt.Print("[H[2J")
 		students := manager.GetStudents()
 		for _, student := range students {
 			fmt.Printf("ID: %s\n", student.ID)
@@ -339,10 +198,24 @@ func main() {
 			filenames = strings.TrimSpace(filenames)
 			files := strings.Split(filenames, ",")
 
-			if err := manager.ImportStudents(files); err != nil {
-				fmt.Println("Error importing students:", err)
+			if pi, ok := manager.(progressImporter); ok {
+				opts := studentmgr.ImportOptions{SkipDuplicates: true, ContinueOnError: true, Concurrency: 4}
+				report, err := pi.ImportStudentsWithProgress(context.Background(), files, opts)
+				if err != nil {
+					fmt.Println("Error importing students:", err)
+				} else if *jsonOutput {
+					report.WriteJSON(os.Stdout)
+				} else {
+					fmt.Printf("Import selesai: %d baris, %d berhasil, %d duplikat, %d gagal\n",
+						report.TotalRows, report.Inserted, report.Duplicates, report.Failed)
+				}
 			} else {
-				fmt.Println("Students imported successfully.")
+				fmt.Println("This manager doesn't support progress reporting; importing without it.")
+				if err := manager.ImportStudents(files); err != nil {
+					fmt.Println("Error importing students:", err)
+				} else {
+					fmt.Println("Import selesai.")
+				}
 			}
 		case "6":
 			fmt.Print("Enter number of assignments to submit: ")
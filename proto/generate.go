@@ -0,0 +1,5 @@
+package proto
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative \
+//go:generate   --go-grpc_out=. --go-grpc_opt=paths=source_relative \
+//go:generate   studentmanager.proto
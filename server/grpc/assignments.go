@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	pb "a21hc3NpZ25tZW50/proto/studentmanagerpb"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamAssignments mirrors the worker pool in studentmgr's submitAssignments, but
+// calls send for every finished job instead of printing to stdout.
+func streamAssignments(numAssignments int, send func(*pb.SubmitAssignmentsProgress) error) error {
+	if numAssignments <= 0 {
+		return status.Errorf(codes.InvalidArgument, "num_assignments must be positive, got %d", numAssignments)
+	}
+
+	jobs := make(chan int, numAssignments)
+	results := make(chan *pb.SubmitAssignmentsProgress)
+	var wg sync.WaitGroup
+
+	const workerCount = 4
+	for w := 1; w <= workerCount; w++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for assignment := range jobs {
+				time.Sleep(40 * time.Millisecond) // Simulated processing
+				results <- &pb.SubmitAssignmentsProgress{
+					Worker:     int32(worker),
+					Assignment: int32(assignment),
+					Message:    fmt.Sprintf("Worker %d: Finished assignment %d", worker, assignment),
+				}
+			}
+		}(w)
+	}
+
+	for i := 1; i <= numAssignments; i++ {
+		jobs <- i
+	}
+	close(jobs)
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for progress := range results {
+		if err := send(progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Package grpc exposes a StudentManager over the network using the service
+// defined in proto/studentmanager.proto. It wraps an existing manager
+// implementation (InMemoryStudentManager, PersistentStudentManager, ...)
+// rather than owning any state itself.
+package grpc
+
+import (
+	"context"
+	"io"
+
+	"a21hc3NpZ25tZW50/model"
+	pb "a21hc3NpZ25tZW50/proto/studentmanagerpb"
+
+	"google.golang.org/grpc"
+)
+
+// StudentManager is the subset of the in-process StudentManager interface
+// the service depends on.
+type StudentManager interface {
+	Login(id, name string) (string, error)
+	Register(id, name, studyProgram string) (string, error)
+	GetStudyProgram(code string) (string, error)
+	ModifyStudent(name string, fn model.StudentModifier) (string, error)
+	ChangeStudyProgram(programStudi string) model.StudentModifier
+}
+
+// Server adapts a StudentManager to the generated StudentManagerServer
+// interface.
+type Server struct {
+	pb.UnimplementedStudentManagerServer
+	manager StudentManager
+}
+
+// NewServer returns a Server backed by manager.
+func NewServer(manager StudentManager) *Server {
+	return &Server{manager: manager}
+}
+
+// Register registers s with grpcServer so it starts handling RPCs once the
+// server starts serving.
+func Register(grpcServer *grpc.Server, s *Server) {
+	pb.RegisterStudentManagerServer(grpcServer, s)
+}
+
+func (s *Server) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	msg, err := s.manager.Login(req.GetId(), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LoginResponse{Message: msg}, nil
+}
+
+func (s *Server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	msg, err := s.manager.Register(req.GetId(), req.GetName(), req.GetStudyProgram())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterResponse{Message: msg}, nil
+}
+
+func (s *Server) GetStudyProgram(ctx context.Context, req *pb.GetStudyProgramRequest) (*pb.GetStudyProgramResponse, error) {
+	name, err := s.manager.GetStudyProgram(req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetStudyProgramResponse{Name: name}, nil
+}
+
+func (s *Server) ModifyStudent(ctx context.Context, req *pb.ModifyStudentRequest) (*pb.ModifyStudentResponse, error) {
+	msg, err := s.manager.ModifyStudent(req.GetName(), s.manager.ChangeStudyProgram(req.GetNewStudyProgram()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ModifyStudentResponse{Message: msg}, nil
+}
+
+// ImportStudents reads a stream of CSV rows sent by the client and registers
+// each one, so clients never need to ship whole files in a single request.
+func (s *Server) ImportStudents(stream pb.StudentManager_ImportStudentsServer) error {
+	imported := 0
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&pb.ImportStudentsResponse{Imported: int32(imported)})
+		}
+		if err != nil {
+			return err
+		}
+
+		student := req.GetStudent()
+		if _, err := s.manager.Register(student.GetId(), student.GetName(), student.GetStudyProgram()); err != nil {
+			return err
+		}
+		imported++
+	}
+}
+
+// SubmitAssignments runs the same worker pool as
+// InMemoryStudentManager.SubmitAssignments but streams one progress event
+// per finished job instead of printing to stdout.
+func (s *Server) SubmitAssignments(req *pb.SubmitAssignmentsRequest, stream pb.StudentManager_SubmitAssignmentsServer) error {
+	return streamAssignments(int(req.GetNumAssignments()), stream.Send)
+}
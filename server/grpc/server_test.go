@@ -0,0 +1,94 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"a21hc3NpZ25tZW50/client"
+	pb "a21hc3NpZ25tZW50/proto/studentmanagerpb"
+	grpcserver "a21hc3NpZ25tZW50/server/grpc"
+	"a21hc3NpZ25tZW50/studentmgr"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startTestServer runs a Server backed by a fresh InMemoryStudentManager on
+// an in-memory listener and returns a Client dialed to it, closing both when
+// the test ends.
+func startTestServer(t *testing.T) *client.Client {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	grpcserver.Register(grpcServer, grpcserver.NewServer(studentmgr.NewInMemoryStudentManager()))
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	c, err := client.Dial("passthrough:///bufconn", grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func TestServerLoginRegisterModifyStudentE2E(t *testing.T) {
+	ctx := context.Background()
+	c := startTestServer(t)
+
+	if _, err := c.Login(ctx, "A12345", "Aditira"); err != nil {
+		t.Fatalf("Login (seeded student): %v", err)
+	}
+
+	if _, err := c.Register(ctx, "Z99999", "Zainal", "TI"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if _, err := c.Login(ctx, "Z99999", "Zainal"); err != nil {
+		t.Fatalf("Login (just registered): %v", err)
+	}
+
+	if _, err := c.Register(ctx, "Z99999", "Zainal", "TI"); err == nil {
+		t.Fatal("Register with a duplicate ID should fail")
+	}
+
+	name, err := c.GetStudyProgram(ctx, "TI")
+	if err != nil {
+		t.Fatalf("GetStudyProgram: %v", err)
+	}
+	if name != "Teknik Informatika" {
+		t.Errorf("GetStudyProgram(TI) = %q, want Teknik Informatika", name)
+	}
+
+	if _, err := c.ModifyStudent(ctx, "Zainal", "TK"); err != nil {
+		t.Fatalf("ModifyStudent: %v", err)
+	}
+	if _, err := c.GetStudyProgram(ctx, "NOPE"); err == nil {
+		t.Fatal("GetStudyProgram with an unknown code should fail")
+	}
+
+	if _, err := c.ModifyStudent(ctx, "Zainal", "TOTALLY_BOGUS_PROGRAM_CODE"); err == nil {
+		t.Fatal("ModifyStudent with an unknown study program code should fail")
+	}
+}
+
+func TestServerSubmitAssignmentsRejectsNonPositiveCount(t *testing.T) {
+	ctx := context.Background()
+	c := startTestServer(t)
+
+	err := c.SubmitAssignments(ctx, -1, func(*pb.SubmitAssignmentsProgress) {})
+	if err == nil {
+		t.Fatal("SubmitAssignments with a negative count should fail, not crash the server")
+	}
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SubmitAssignments(-1) error code = %v, want InvalidArgument", status.Code(err))
+	}
+}
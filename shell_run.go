@@ -0,0 +1,25 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"a21hc3NpZ25tZW50/cli/shell"
+	"a21hc3NpZ25tZW50/studentmgr"
+)
+
+// runShell drives manager through the interactive shell, persisting command
+// history to ~/.studentmgr_history.
+func runShell(manager studentmgr.StudentManager) error {
+	historyPath := historyFilePath()
+	sh := shell.New(manager, os.Stdin, os.Stdout, historyPath, manager.ChangeStudyProgram)
+	return sh.Run()
+}
+
+func historyFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".studentmgr_history")
+}
@@ -0,0 +1,250 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"a21hc3NpZ25tZW50/model"
+)
+
+// fileStoreData is the on-disk representation written by FileStore. It is
+// kept separate from model.Student so the JSON schema can evolve without
+// touching the domain model.
+type fileStoreData struct {
+	Students            []model.Student   `json:"students"`
+	StudyPrograms       map[string]string `json:"study_programs"`
+	FailedLoginAttempts map[string]int    `json:"failed_login_attempts"`
+}
+
+// FileStore is a Store backed by a single JSON file. It is the simplest
+// driver available and the one used by default; BoltDBStore or SQLStore can
+// be dropped in behind the same Store interface once those dependencies are
+// available.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	data fileStoreData
+}
+
+// NewFileStore opens (or creates) the JSON file at path and returns a Store
+// backed by it. If the file does not exist yet it is seeded with empty
+// collections and study programs must be populated by the caller.
+func NewFileStore(path string) (*FileStore, error) {
+	fs := &FileStore{
+		path: path,
+		data: fileStoreData{
+			StudyPrograms:       make(map[string]string),
+			FailedLoginAttempts: make(map[string]int),
+		},
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			if err := fs.save(); err != nil {
+				return nil, fmt.Errorf("store: seed %s: %w", path, err)
+			}
+			return fs, nil
+		}
+		return nil, fmt.Errorf("store: open %s: %w", path, err)
+	}
+
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &fs.data); err != nil {
+			return nil, fmt.Errorf("store: decode %s: %w", path, err)
+		}
+	}
+	if fs.data.StudyPrograms == nil {
+		fs.data.StudyPrograms = make(map[string]string)
+	}
+	if fs.data.FailedLoginAttempts == nil {
+		fs.data.FailedLoginAttempts = make(map[string]int)
+	}
+	return fs, nil
+}
+
+// save writes the current in-memory state to disk atomically (write to a
+// temp file, then rename) so a crash mid-write never corrupts the store.
+func (fs *FileStore) save() error {
+	raw, err := json.MarshalIndent(fs.data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := fs.path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, fs.path)
+}
+
+func (fs *FileStore) Students() ([]model.Student, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	students := make([]model.Student, len(fs.data.Students))
+	copy(students, fs.data.Students)
+	return students, nil
+}
+
+func (fs *FileStore) GetStudent(id string) (model.Student, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	for _, s := range fs.data.Students {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return model.Student{}, ErrNotFound
+}
+
+func (fs *FileStore) PutStudent(s model.Student) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	for i, existing := range fs.data.Students {
+		if existing.ID == s.ID {
+			fs.data.Students[i] = s
+			return fs.save()
+		}
+	}
+	fs.data.Students = append(fs.data.Students, s)
+	return fs.save()
+}
+
+func (fs *FileStore) StudyPrograms() (map[string]string, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	programs := make(map[string]string, len(fs.data.StudyPrograms))
+	for code, name := range fs.data.StudyPrograms {
+		programs[code] = name
+	}
+	return programs, nil
+}
+
+func (fs *FileStore) SetStudyProgram(code, name string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.data.StudyPrograms[code] = name
+	return fs.save()
+}
+
+func (fs *FileStore) FailedLoginAttempts(id string) (int, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.data.FailedLoginAttempts[id], nil
+}
+
+func (fs *FileStore) SetFailedLoginAttempts(id string, attempts int) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	fs.data.FailedLoginAttempts[id] = attempts
+	return fs.save()
+}
+
+// Update implements Store.Update by running fn against a scratch copy of
+// fs.data. fs.data (and the file on disk) is only replaced once fn returns
+// nil, so a failed fn leaves the store exactly as it was.
+func (fs *FileStore) Update(fn func(Tx) error) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	tx := &fileStoreTx{data: cloneFileStoreData(fs.data)}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	fs.data = tx.data
+	return fs.save()
+}
+
+func (fs *FileStore) Close() error {
+	return nil
+}
+
+// cloneFileStoreData deep-copies d so a transaction can mutate its own copy
+// without touching the committed state until it commits.
+func cloneFileStoreData(d fileStoreData) fileStoreData {
+	clone := fileStoreData{
+		Students:            make([]model.Student, len(d.Students)),
+		StudyPrograms:       make(map[string]string, len(d.StudyPrograms)),
+		FailedLoginAttempts: make(map[string]int, len(d.FailedLoginAttempts)),
+	}
+	copy(clone.Students, d.Students)
+	for k, v := range d.StudyPrograms {
+		clone.StudyPrograms[k] = v
+	}
+	for k, v := range d.FailedLoginAttempts {
+		clone.FailedLoginAttempts[k] = v
+	}
+	return clone
+}
+
+// fileStoreTx is the Tx handed to the fn passed to FileStore.Update. It reads
+// and writes a scratch fileStoreData that only replaces the store's
+// committed data if fn returns nil; fs.mu is already held by Update for the
+// whole transaction so fileStoreTx needs no locking of its own.
+type fileStoreTx struct {
+	data fileStoreData
+}
+
+func (tx *fileStoreTx) Students() ([]model.Student, error) {
+	students := make([]model.Student, len(tx.data.Students))
+	copy(students, tx.data.Students)
+	return students, nil
+}
+
+func (tx *fileStoreTx) GetStudent(id string) (model.Student, error) {
+	for _, s := range tx.data.Students {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return model.Student{}, ErrNotFound
+}
+
+func (tx *fileStoreTx) PutStudent(s model.Student) error {
+	for i, existing := range tx.data.Students {
+		if existing.ID == s.ID {
+			tx.data.Students[i] = s
+			return nil
+		}
+	}
+	tx.data.Students = append(tx.data.Students, s)
+	return nil
+}
+
+func (tx *fileStoreTx) StudyPrograms() (map[string]string, error) {
+	programs := make(map[string]string, len(tx.data.StudyPrograms))
+	for code, name := range tx.data.StudyPrograms {
+		programs[code] = name
+	}
+	return programs, nil
+}
+
+func (tx *fileStoreTx) SetStudyProgram(code, name string) error {
+	tx.data.StudyPrograms[code] = name
+	return nil
+}
+
+func (tx *fileStoreTx) FailedLoginAttempts(id string) (int, error) {
+	return tx.data.FailedLoginAttempts[id], nil
+}
+
+func (tx *fileStoreTx) SetFailedLoginAttempts(id string, attempts int) error {
+	tx.data.FailedLoginAttempts[id] = attempts
+	return nil
+}
+
+// pathExists reports whether path already exists on disk. Nothing in the
+// package calls it at present.
+func pathExists(path string) bool {
+	_, err := os.Stat(filepath.Clean(path))
+	return err == nil
+}
@@ -0,0 +1,91 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+
+	"a21hc3NpZ25tZW50/model"
+)
+
+func TestFileStoreReopenPersistsState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "students.json")
+
+	fs, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	if err := fs.SetStudyProgram("TI", "Teknik Informatika"); err != nil {
+		t.Fatalf("SetStudyProgram: %v", err)
+	}
+	if err := fs.PutStudent(model.Student{ID: "A1", Name: "Budi", StudyProgram: "TI"}); err != nil {
+		t.Fatalf("PutStudent: %v", err)
+	}
+	if err := fs.SetFailedLoginAttempts("A1", 2); err != nil {
+		t.Fatalf("SetFailedLoginAttempts: %v", err)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	reopened, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore (reopen): %v", err)
+	}
+
+	student, err := reopened.GetStudent("A1")
+	if err != nil {
+		t.Fatalf("GetStudent after reopen: %v", err)
+	}
+	if student.Name != "Budi" || student.StudyProgram != "TI" {
+		t.Errorf("GetStudent after reopen = %+v, want Budi/TI", student)
+	}
+
+	programs, err := reopened.StudyPrograms()
+	if err != nil {
+		t.Fatalf("StudyPrograms after reopen: %v", err)
+	}
+	if programs["TI"] != "Teknik Informatika" {
+		t.Errorf("StudyPrograms[TI] after reopen = %q, want Teknik Informatika", programs["TI"])
+	}
+
+	attempts, err := reopened.FailedLoginAttempts("A1")
+	if err != nil {
+		t.Fatalf("FailedLoginAttempts after reopen: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("FailedLoginAttempts after reopen = %d, want 2", attempts)
+	}
+}
+
+func TestFileStoreUpdateRollsBackOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "students.json")
+
+	fs, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+
+	wantErr := ErrNotFound
+	err = fs.Update(func(tx Tx) error {
+		if err := tx.PutStudent(model.Student{ID: "A1", Name: "Budi", StudyProgram: "TI"}); err != nil {
+			return err
+		}
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("Update error = %v, want %v", err, wantErr)
+	}
+
+	if _, err := fs.GetStudent("A1"); err != ErrNotFound {
+		t.Errorf("GetStudent after rolled-back Update = %v, want ErrNotFound", err)
+	}
+
+	if err := fs.Update(func(tx Tx) error {
+		return tx.PutStudent(model.Student{ID: "A1", Name: "Budi", StudyProgram: "TI"})
+	}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if _, err := fs.GetStudent("A1"); err != nil {
+		t.Errorf("GetStudent after committed Update: %v", err)
+	}
+}
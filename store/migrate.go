@@ -0,0 +1,23 @@
+package store
+
+import "a21hc3NpZ25tZW50/model"
+
+// studentPutter is the part of Store (or a Tx within an Update) that
+// MigrateStudents needs, so callers can run it either directly against a
+// Store or inside a transaction.
+type studentPutter interface {
+	PutStudent(s model.Student) error
+}
+
+// MigrateStudents writes students into s, seeding the store with a one-time
+// import from a legacy source (typically CSV files previously handled by
+// InMemoryStudentManager.ImportStudents). Existing records with the same ID
+// are overwritten.
+func MigrateStudents(s studentPutter, students []model.Student) error {
+	for _, student := range students {
+		if err := s.PutStudent(student); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Package store defines the persistence layer used by PersistentStudentManager.
+//
+// A Store is responsible for durably recording students, study programs and
+// failed-login counters. Concrete drivers (file-based, BoltDB, SQL, ...) live
+// alongside this file and must satisfy the Store interface so the manager can
+// swap backends without changing any business logic.
+package store
+
+import (
+	"errors"
+
+	"a21hc3NpZ25tZW50/model"
+)
+
+// ErrNotFound is returned by Store implementations when a lookup does not
+// match any record.
+var ErrNotFound = errors.New("store: not found")
+
+// Store is implemented by every persistence backend. Implementations must be
+// safe for concurrent use by multiple goroutines.
+type Store interface {
+	// Students returns every student currently on record, in no particular order.
+	Students() ([]model.Student, error)
+
+	// GetStudent looks up a single student by ID. It returns ErrNotFound if
+	// no student with that ID has been recorded.
+	GetStudent(id string) (model.Student, error)
+
+	// PutStudent inserts or replaces the student record keyed by its ID.
+	PutStudent(s model.Student) error
+
+	// StudyPrograms returns the code -> name mapping known to the store.
+	StudyPrograms() (map[string]string, error)
+
+	// SetStudyProgram adds or renames a study program code.
+	SetStudyProgram(code, name string) error
+
+	// FailedLoginAttempts returns the current attempt counter for id. A
+	// student that has never failed a login returns 0, nil.
+	FailedLoginAttempts(id string) (int, error)
+
+	// SetFailedLoginAttempts persists the attempt counter for id.
+	SetFailedLoginAttempts(id string, attempts int) error
+
+	// Update runs fn against a transaction: every mutation fn makes through
+	// the Tx is buffered and committed in a single atomic write if fn
+	// returns nil, or discarded entirely (no write at all) if fn returns an
+	// error. Callers that need to make more than one related change -
+	// e.g. checking a student doesn't exist and then inserting it - should
+	// do so inside Update instead of issuing separate Store calls, so a
+	// failure partway through never leaves the store half-written.
+	Update(fn func(Tx) error) error
+
+	// Close releases any resources held by the store (file handles, DB
+	// connections, ...). It is safe to call Close more than once.
+	Close() error
+}
+
+// Tx exposes the same reads and writes as Store, but scoped to a single
+// Update call: none of its writes are durable until Update's fn returns
+// nil.
+type Tx interface {
+	Students() ([]model.Student, error)
+	GetStudent(id string) (model.Student, error)
+	PutStudent(s model.Student) error
+	StudyPrograms() (map[string]string, error)
+	SetStudyProgram(code, name string) error
+	FailedLoginAttempts(id string) (int, error)
+	SetFailedLoginAttempts(id string, attempts int) error
+}
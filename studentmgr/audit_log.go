@@ -0,0 +1,42 @@
+package studentmgr
+
+import (
+	"time"
+
+	"a21hc3NpZ25tZW50/audit"
+)
+
+// EnableAudit attaches logger to sm; every future Login, Register,
+// ModifyStudent and ImportStudents call is recorded through it. Passing nil
+// disables auditing again.
+func (sm *InMemoryStudentManager) EnableAudit(logger *audit.Logger) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.audit = logger
+}
+
+// Audit returns a channel of live audit events for subscribers such as an
+// operator dashboard. It returns nil if auditing hasn't been enabled via
+// EnableAudit.
+func (sm *InMemoryStudentManager) Audit() <-chan audit.Event {
+	sm.Lock()
+	defer sm.Unlock()
+	if sm.audit == nil {
+		return nil
+	}
+	return sm.audit.Subscribe()
+}
+
+// logAudit is a no-op until EnableAudit has been called.
+func (sm *InMemoryStudentManager) logAudit(actor string, action audit.Action, outcome audit.Outcome, fields map[string]string) {
+	if sm.audit == nil {
+		return
+	}
+	_ = sm.audit.Log(audit.Event{
+		Timestamp: time.Now(),
+		Actor:     actor,
+		Action:    action,
+		Outcome:   outcome,
+		Fields:    fields,
+	})
+}
@@ -0,0 +1,145 @@
+package studentmgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// ImportOptions controls how ImportStudentsWithProgress behaves when a batch
+// import runs into bad data.
+type ImportOptions struct {
+	// SkipDuplicates, when true, counts an already-registered student as a
+	// duplicate instead of failing the row.
+	SkipDuplicates bool
+	// ContinueOnError, when true, keeps importing the remaining rows and
+	// files after a row fails instead of aborting the whole import.
+	ContinueOnError bool
+	// Concurrency caps how many files are read in parallel. Zero means 1.
+	Concurrency int
+}
+
+// ImportReport summarizes the outcome of a bulk import.
+type ImportReport struct {
+	TotalRows     int               `json:"total_rows"`
+	Inserted      int               `json:"inserted"`
+	Duplicates    int               `json:"duplicates"`
+	Failed        int               `json:"failed"`
+	PerFileErrors map[string]string `json:"per_file_errors,omitempty"`
+}
+
+// WriteJSON writes the report as JSON, used when the CLI is invoked with
+// --json.
+func (r *ImportReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+const duplicateIDMessage = "Registrasi gagal: id sudah digunakan"
+
+// ImportStudentsWithProgress imports filenames the same way ImportStudents
+// does, but a single malformed row or missing file no longer aborts the
+// whole batch unless opts says otherwise, it returns a structured report
+// instead of swallowing errors, and it drives a progress bar on stderr when
+// attached to a terminal. A file that cannot be opened or parsed (one
+// malformed row is enough) is rejected whole: none of its rows are imported
+// and it counts once in Failed and PerFileErrors.
+func (sm *InMemoryStudentManager) ImportStudentsWithProgress(ctx context.Context, filenames []string, opts ImportOptions) (*ImportReport, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	report := &ImportReport{PerFileErrors: make(map[string]string)}
+	var mu sync.Mutex
+	aborted := false // set once a failure stops the import (ContinueOnError off)
+
+	var bar *progressBar
+	if isTerminal(os.Stderr) {
+		bar = newProgressBar(len(filenames), os.Stderr)
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for _, filename := range filenames {
+		if ctx.Err() != nil {
+			mu.Lock()
+			report.PerFileErrors[filename] = ctx.Err().Error()
+			mu.Unlock()
+			continue
+		}
+
+		sem <- struct{}{}
+		mu.Lock()
+		stop := aborted
+		mu.Unlock()
+		if stop {
+			<-sem
+			break
+		}
+		wg.Add(1)
+		go func(f string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if bar != nil {
+				defer bar.Increment()
+			}
+
+			students, err := ReadStudentsFromCSV(f)
+			if err != nil {
+				mu.Lock()
+				report.PerFileErrors[f] = err.Error()
+				report.Failed++
+				aborted = aborted || !opts.ContinueOnError
+				mu.Unlock()
+				return
+			}
+
+			for _, student := range students {
+				mu.Lock()
+				if aborted {
+					mu.Unlock()
+					return
+				}
+				report.TotalRows++
+				mu.Unlock()
+
+				_, err := sm.Register(student.ID, student.Name, student.StudyProgram)
+				switch {
+				case err == nil:
+					mu.Lock()
+					report.Inserted++
+					mu.Unlock()
+				case opts.SkipDuplicates && err.Error() == duplicateIDMessage:
+					mu.Lock()
+					report.Duplicates++
+					mu.Unlock()
+				default:
+					mu.Lock()
+					report.Failed++
+					report.PerFileErrors[fmt.Sprintf("%s:%s", f, student.ID)] = err.Error()
+					aborted = aborted || !opts.ContinueOnError
+					mu.Unlock()
+					if !opts.ContinueOnError {
+						return
+					}
+				}
+			}
+		}(filename)
+	}
+
+	wg.Wait()
+	if bar != nil {
+		bar.Finish()
+	}
+
+	if len(report.PerFileErrors) == 0 {
+		report.PerFileErrors = nil
+	}
+	return report, nil
+}
@@ -0,0 +1,138 @@
+package studentmgr
+
+import (
+	"sync"
+	"time"
+)
+
+// LoginLimiter enforces exponential backoff between failed login attempts
+// for a given ID instead of a permanent lock after three tries. Attempts
+// are forgotten once they've sat idle for resetAfter, so a one-off mistake
+// doesn't follow a student around for the rest of the process lifetime.
+type LoginLimiter struct {
+	mu         sync.Mutex
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+	resetAfter time.Duration
+	now        func() time.Time
+	state      map[string]*limiterState
+}
+
+type limiterState struct {
+	attempts    int
+	lastAttempt time.Time
+}
+
+// NewLoginLimiter returns a LoginLimiter whose backoff starts at baseDelay,
+// doubles on every consecutive failure up to maxDelay, and forgets a
+// student's attempts once resetAfter has passed since the last one.
+func NewLoginLimiter(baseDelay, maxDelay, resetAfter time.Duration) *LoginLimiter {
+	return &LoginLimiter{
+		baseDelay:  baseDelay,
+		maxDelay:   maxDelay,
+		resetAfter: resetAfter,
+		now:        time.Now,
+		state:      make(map[string]*limiterState),
+	}
+}
+
+// Allow reports whether id may attempt a login right now. If not, it also
+// returns the time at which the next attempt will be allowed.
+func (l *LoginLimiter) Allow(id string) (ok bool, nextAllowed time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	st, exists := l.state[id]
+	if !exists {
+		return true, time.Time{}
+	}
+
+	now := l.now()
+	if now.Sub(st.lastAttempt) > l.resetAfter {
+		delete(l.state, id)
+		return true, time.Time{}
+	}
+
+	allowedAt := st.lastAttempt.Add(l.backoff(st.attempts))
+	if now.Before(allowedAt) {
+		return false, allowedAt
+	}
+	return true, time.Time{}
+}
+
+// RecordFailure registers a failed attempt for id, advancing its backoff.
+func (l *LoginLimiter) RecordFailure(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	st, exists := l.state[id]
+	if !exists {
+		st = &limiterState{}
+		l.state[id] = st
+	}
+	st.attempts++
+	st.lastAttempt = l.now()
+}
+
+// RecordSuccess clears any backoff state for id.
+func (l *LoginLimiter) RecordSuccess(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.state, id)
+}
+
+// Seed initializes id's attempt count to attempts, as of now, for restoring
+// state recorded before the process last restarted. It has no effect if
+// attempts is zero or negative.
+func (l *LoginLimiter) Seed(id string, attempts int) {
+	if attempts <= 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.state[id] = &limiterState{attempts: attempts, lastAttempt: l.now()}
+}
+
+// Unlock clears any backoff state for id immediately. It is the admin
+// recovery path that a permanent, process-lifetime lock never had.
+func (l *LoginLimiter) Unlock(id string) {
+	l.RecordSuccess(id)
+}
+
+// Attempts returns how many consecutive failures have been recorded for id
+// and when it may next attempt a login (the zero Time if it already can).
+func (l *LoginLimiter) Attempts(id string) (count int, nextAllowed time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	st, exists := l.state[id]
+	if !exists {
+		return 0, time.Time{}
+	}
+
+	now := l.now()
+	if now.Sub(st.lastAttempt) > l.resetAfter {
+		delete(l.state, id)
+		return 0, time.Time{}
+	}
+
+	allowedAt := st.lastAttempt.Add(l.backoff(st.attempts))
+	if now.Before(allowedAt) {
+		return st.attempts, allowedAt
+	}
+	return st.attempts, time.Time{}
+}
+
+func (l *LoginLimiter) backoff(attempts int) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+	delay := l.baseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= l.maxDelay {
+			return l.maxDelay
+		}
+	}
+	return delay
+}
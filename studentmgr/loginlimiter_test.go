@@ -0,0 +1,127 @@
+package studentmgr
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock lets tests advance a LoginLimiter's or TokenBucket's notion of
+// "now" deterministically instead of racing against a real timer.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newFakeLoginLimiter(clock *fakeClock, baseDelay, maxDelay, resetAfter time.Duration) *LoginLimiter {
+	l := NewLoginLimiter(baseDelay, maxDelay, resetAfter)
+	l.now = clock.now
+	return l
+}
+
+func TestLoginLimiterAllowsFirstAttempt(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 30*time.Second, 5*time.Minute)
+
+	if ok, _ := l.Allow("A1"); !ok {
+		t.Fatal("Allow on an ID with no history should be true")
+	}
+}
+
+func TestLoginLimiterBacksOffExponentially(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 30*time.Second, 5*time.Minute)
+
+	l.RecordFailure("A1")
+	if ok, next := l.Allow("A1"); ok {
+		t.Fatal("Allow immediately after one failure should be false")
+	} else if want := clock.now().Add(time.Second); !next.Equal(want) {
+		t.Errorf("nextAllowed = %v, want %v", next, want)
+	}
+
+	clock.advance(time.Second)
+	if ok, _ := l.Allow("A1"); !ok {
+		t.Fatal("Allow after the 1s backoff elapses should be true")
+	}
+
+	l.RecordFailure("A1")
+	clock.advance(time.Second)
+	if ok, _ := l.Allow("A1"); ok {
+		t.Fatal("Allow after a second failure should still be backed off at 1s (backoff is now 2s)")
+	}
+
+	clock.advance(time.Second)
+	if ok, _ := l.Allow("A1"); !ok {
+		t.Fatal("Allow after the 2s backoff elapses should be true")
+	}
+}
+
+func TestLoginLimiterCapsAtMaxDelay(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 4*time.Second, 5*time.Minute)
+
+	for i := 0; i < 5; i++ {
+		l.RecordFailure("A1")
+	}
+	if got := l.backoff(5); got != 4*time.Second {
+		t.Errorf("backoff(5) = %v, want capped at 4s", got)
+	}
+}
+
+func TestLoginLimiterRecordSuccessClearsState(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 30*time.Second, 5*time.Minute)
+
+	l.RecordFailure("A1")
+	l.RecordSuccess("A1")
+	if ok, _ := l.Allow("A1"); !ok {
+		t.Fatal("Allow after RecordSuccess should be true")
+	}
+	if count, _ := l.Attempts("A1"); count != 0 {
+		t.Errorf("Attempts after RecordSuccess = %d, want 0", count)
+	}
+}
+
+func TestLoginLimiterForgetsAttemptsAfterResetAfter(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 30*time.Second, time.Minute)
+
+	l.RecordFailure("A1")
+	clock.advance(2 * time.Minute)
+	if ok, _ := l.Allow("A1"); !ok {
+		t.Fatal("Allow after resetAfter has elapsed should be true")
+	}
+	if count, _ := l.Attempts("A1"); count != 0 {
+		t.Errorf("Attempts after the reset window should be 0, got %d", count)
+	}
+}
+
+func TestLoginLimiterSeed(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 30*time.Second, 5*time.Minute)
+
+	l.Seed("A1", 3)
+	if ok, _ := l.Allow("A1"); ok {
+		t.Fatal("Allow right after seeding with prior attempts should be backed off")
+	}
+	if count, _ := l.Attempts("A1"); count != 3 {
+		t.Errorf("Attempts after Seed(3) = %d, want 3", count)
+	}
+
+	l.Seed("B2", 0)
+	if ok, _ := l.Allow("B2"); !ok {
+		t.Error("Seed with zero attempts should have no effect")
+	}
+}
+
+func TestLoginLimiterUnlockClearsBackoff(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	l := newFakeLoginLimiter(clock, time.Second, 30*time.Second, 5*time.Minute)
+
+	l.RecordFailure("A1")
+	l.Unlock("A1")
+	if ok, _ := l.Allow("A1"); !ok {
+		t.Fatal("Allow after Unlock should be true")
+	}
+}
@@ -0,0 +1,305 @@
+package studentmgr
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"a21hc3NpZ25tZW50/audit"
+	"a21hc3NpZ25tZW50/model"
+	"a21hc3NpZ25tZW50/store"
+)
+
+// PersistentStudentManager is a StudentManager whose state survives process
+// restarts by going through a store.Store instead of keeping everything in
+// slices and maps. It otherwise behaves like InMemoryStudentManager, except
+// that ImportStudents overwrites students whose ID is already recorded
+// instead of failing on them, stops at the first file it cannot read, and
+// there is no ImportStudentsWithProgress. Login backoff timing is still kept in-process (a
+// LoginLimiter, not the store), but the failed-attempt counter backing it is
+// persisted through the store so a restart mid-backoff doesn't give an
+// attacker a free reset.
+type PersistentStudentManager struct {
+	sync.Mutex
+	store          store.Store
+	loginLimiter   *LoginLimiter
+	globalLimiter  *TokenBucket // optional; nil disables global throttling
+	audit          *audit.Logger
+	studyPrograms  map[string]string // cached at construction; validated without re-entering the store
+	seededAttempts map[string]bool   // ids whose failed-attempt count has been loaded from the store this run
+}
+
+// NewPersistentStudentManager wraps store with a StudentManager. If the
+// store has no study programs recorded yet (a brand new database) it is
+// seeded with the default set so a fresh install behaves like
+// NewInMemoryStudentManager.
+func NewPersistentStudentManager(s store.Store) (*PersistentStudentManager, error) {
+	sm := &PersistentStudentManager{
+		store:          s,
+		loginLimiter:   NewLoginLimiter(1*time.Second, 30*time.Second, 5*time.Minute),
+		seededAttempts: make(map[string]bool),
+	}
+
+	programs, err := s.StudyPrograms()
+	if err != nil {
+		return nil, fmt.Errorf("memuat program studi: %w", err)
+	}
+	if len(programs) == 0 {
+		defaults := map[string]string{
+			"TI": "Teknik Informatika",
+			"TK": "Teknik Komputer",
+			"SI": "Sistem Informasi",
+			"MI": "Manajemen Informasi",
+		}
+		for code, name := range defaults {
+			if err := s.SetStudyProgram(code, name); err != nil {
+				return nil, err
+			}
+		}
+		programs = defaults
+	}
+	sm.studyPrograms = programs
+	return sm, nil
+}
+
+func (sm *PersistentStudentManager) GetStudents() []model.Student {
+	students, err := sm.store.Students()
+	if err != nil {
+		return nil
+	}
+	return students
+}
+
+func (sm *PersistentStudentManager) Login(id string, name string) (string, error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	if id == "" {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, map[string]string{"error": "empty id"})
+		return "", fmt.Errorf("Login gagal: ID tidak boleh kosong")
+	}
+	if name == "" {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, map[string]string{"error": "empty name"})
+		return "", fmt.Errorf("Login gagal: Nama tidak boleh kosong")
+	}
+
+	if sm.globalLimiter != nil && !sm.globalLimiter.Allow() {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeLockout, map[string]string{"reason": "global_rate_limit"})
+		return "", fmt.Errorf("Login gagal: terlalu banyak percobaan login, coba lagi sebentar lagi")
+	}
+
+	sm.seedLoginAttempts(id)
+
+	if ok, nextAllowed := sm.loginLimiter.Allow(id); !ok {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeLockout, map[string]string{"next_allowed": nextAllowed.Format(time.RFC3339)})
+		return "", fmt.Errorf("Login gagal: terlalu banyak percobaan, coba lagi setelah %s", nextAllowed.Format(time.RFC3339))
+	}
+
+	student, err := sm.store.GetStudent(id)
+	if err == store.ErrNotFound {
+		sm.recordLoginFailure(id)
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, nil)
+		return "", fmt.Errorf("Login gagal: data mahasiswa tidak ditemukan")
+	}
+	if err != nil {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+		return "", fmt.Errorf("Login gagal: %w", err)
+	}
+
+	if student.Name != name {
+		sm.recordLoginFailure(id)
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, nil)
+		return "", fmt.Errorf("Login gagal: data mahasiswa tidak ditemukan")
+	}
+	sm.loginLimiter.RecordSuccess(id)
+	if err := sm.store.SetFailedLoginAttempts(id, 0); err != nil {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+		return "", fmt.Errorf("Login gagal: %w", err)
+	}
+
+	programs, err := sm.store.StudyPrograms()
+	if err != nil {
+		sm.logAudit(id, audit.ActionLogin, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+		return "", fmt.Errorf("Login gagal: %w", err)
+	}
+	sm.logAudit(id, audit.ActionLogin, audit.OutcomeSuccess, nil)
+	return fmt.Sprintf("Login berhasil: Selamat datang %s! Kamu terdaftar di program studi: %s", student.Name, programs[student.StudyProgram]), nil
+}
+
+// EnableAudit attaches logger to sm; every future Login, Register,
+// ModifyStudent and ImportStudents call is recorded through it. Passing nil
+// disables auditing again.
+func (sm *PersistentStudentManager) EnableAudit(logger *audit.Logger) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.audit = logger
+}
+
+// SetGlobalLoginLimiter enables a token-bucket limiter shared across every
+// ID, for blunting brute-force attempts that spread guesses across many
+// student IDs instead of hammering one. Pass nil to disable it again.
+func (sm *PersistentStudentManager) SetGlobalLoginLimiter(limiter *TokenBucket) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.globalLimiter = limiter
+}
+
+// logAudit is a no-op until EnableAudit has been called.
+func (sm *PersistentStudentManager) logAudit(actor string, action audit.Action, outcome audit.Outcome, fields map[string]string) {
+	if sm.audit == nil {
+		return
+	}
+	_ = sm.audit.Log(audit.Event{
+		Timestamp: time.Now(),
+		Actor:     actor,
+		Action:    action,
+		Outcome:   outcome,
+		Fields:    fields,
+	})
+}
+
+// seedLoginAttempts loads id's failed-attempt count from the store into the
+// in-process LoginLimiter the first time this process sees id, so a restart
+// mid-backoff doesn't silently give an attacker a clean slate. It is a
+// no-op on every later call for the same id.
+func (sm *PersistentStudentManager) seedLoginAttempts(id string) {
+	if sm.seededAttempts[id] {
+		return
+	}
+	sm.seededAttempts[id] = true
+	if attempts, err := sm.store.FailedLoginAttempts(id); err == nil {
+		sm.loginLimiter.Seed(id, attempts)
+	}
+}
+
+// recordLoginFailure advances id's in-process backoff and persists the new
+// attempt count so it survives a restart.
+func (sm *PersistentStudentManager) recordLoginFailure(id string) {
+	sm.loginLimiter.RecordFailure(id)
+	attempts, _ := sm.loginLimiter.Attempts(id)
+	_ = sm.store.SetFailedLoginAttempts(id, attempts)
+}
+
+func (sm *PersistentStudentManager) Register(id string, name string, studyProgram string) (string, error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	if id == "" || name == "" || studyProgram == "" {
+		sm.logAudit(id, audit.ActionRegister, audit.OutcomeFailure, map[string]string{"error": "ID, Name or StudyProgram is undefined!"})
+		return "", fmt.Errorf("ID, Name or StudyProgram is undefined!")
+	}
+
+	if _, exists := sm.studyPrograms[studyProgram]; !exists {
+		sm.logAudit(id, audit.ActionRegister, audit.OutcomeFailure, map[string]string{"error": fmt.Sprintf("Study program %s is not found", studyProgram)})
+		return "", fmt.Errorf("Study program %s is not found", studyProgram)
+	}
+
+	newStudent := model.Student{ID: id, Name: name, StudyProgram: studyProgram}
+
+	// The duplicate-ID check and the insert must happen as one unit: two
+	// Register calls racing between a separate check and a separate write
+	// could otherwise both see "not found" and both insert.
+	err := sm.store.Update(func(tx store.Tx) error {
+		if _, err := tx.GetStudent(id); err == nil {
+			return fmt.Errorf("Registrasi gagal: id sudah digunakan")
+		} else if err != store.ErrNotFound {
+			return fmt.Errorf("Registrasi gagal: %w", err)
+		}
+		return tx.PutStudent(newStudent)
+	})
+	if err != nil {
+		sm.logAudit(id, audit.ActionRegister, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+		return "", err
+	}
+	sm.logAudit(id, audit.ActionRegister, audit.OutcomeSuccess, map[string]string{"study_program": studyProgram})
+	return fmt.Sprintf("Registrasi berhasil: %s (%s)", newStudent.Name, newStudent.StudyProgram), nil
+}
+
+func (sm *PersistentStudentManager) GetStudyProgram(code string) (string, error) {
+	programs, err := sm.store.StudyPrograms()
+	if err != nil {
+		return "", err
+	}
+	if program, exists := programs[code]; exists {
+		return program, nil
+	}
+	return "", fmt.Errorf("program studi tidak ditemukan")
+}
+
+func (sm *PersistentStudentManager) ModifyStudent(name string, fn model.StudentModifier) (string, error) {
+	sm.Lock()
+	defer sm.Unlock()
+
+	err := sm.store.Update(func(tx store.Tx) error {
+		students, err := tx.Students()
+		if err != nil {
+			return err
+		}
+		for _, student := range students {
+			if student.Name == name {
+				if err := fn(&student); err != nil {
+					return err
+				}
+				return tx.PutStudent(student)
+			}
+		}
+		return fmt.Errorf("Mahasiswa tidak ditemukan")
+	})
+	if err != nil {
+		sm.logAudit(name, audit.ActionModifyStudent, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+		return "", err
+	}
+	sm.logAudit(name, audit.ActionModifyStudent, audit.OutcomeSuccess, nil)
+	return "Program studi mahasiswa berhasil diubah.", nil
+}
+
+// ChangeStudyProgram mirrors InMemoryStudentManager.ChangeStudyProgram,
+// validating against the cached study-program set instead of the store so
+// it is safe to call from inside ModifyStudent's transaction.
+func (sm *PersistentStudentManager) ChangeStudyProgram(programStudi string) model.StudentModifier {
+	return func(s *model.Student) error {
+		if _, exists := sm.studyPrograms[programStudi]; !exists {
+			return fmt.Errorf("program studi tidak valid")
+		}
+		s.StudyProgram = programStudi
+		return nil
+	}
+}
+
+func (sm *PersistentStudentManager) ImportStudents(filenames []string) error {
+	imported := 0
+	for _, filename := range filenames {
+		students, err := ReadStudentsFromCSV(filename)
+		if err != nil {
+			sm.logAudit("system", audit.ActionImportStudents, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+			return err
+		}
+		// Each file's rows land in the store as one transaction, so a
+		// failure partway through a file doesn't leave it half-imported.
+		if err := sm.store.Update(func(tx store.Tx) error {
+			return store.MigrateStudents(tx, students)
+		}); err != nil {
+			sm.logAudit("system", audit.ActionImportStudents, audit.OutcomeFailure, map[string]string{"error": err.Error()})
+			return err
+		}
+		imported += len(students)
+	}
+	sm.logAudit("system", audit.ActionImportStudents, audit.OutcomeSuccess, map[string]string{"imported": fmt.Sprintf("%d", imported)})
+	return nil
+}
+
+func (sm *PersistentStudentManager) SubmitAssignments(numAssignments int) {
+	submitAssignments(numAssignments)
+}
+
+func (sm *PersistentStudentManager) UnlockStudent(id string) error {
+	sm.loginLimiter.Unlock(id)
+	return sm.store.SetFailedLoginAttempts(id, 0)
+}
+
+func (sm *PersistentStudentManager) LoginAttempts(id string) (count int, nextAllowed time.Time) {
+	sm.Lock()
+	defer sm.Unlock()
+	sm.seedLoginAttempts(id)
+	return sm.loginLimiter.Attempts(id)
+}
@@ -0,0 +1,157 @@
+package studentmgr
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"a21hc3NpZ25tZW50/audit"
+	"a21hc3NpZ25tZW50/store"
+)
+
+func TestPersistentStudentManagerSurvivesRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "students.json")
+
+	fs, err := store.NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	sm, err := NewPersistentStudentManager(fs)
+	if err != nil {
+		t.Fatalf("NewPersistentStudentManager: %v", err)
+	}
+
+	if _, err := sm.Register("A1", "Budi", "TI"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if _, err := sm.Login("A1", "wrong name"); err == nil {
+		t.Fatal("Login with wrong name should fail")
+	}
+	if count, _ := sm.LoginAttempts("A1"); count != 1 {
+		t.Fatalf("LoginAttempts before restart = %d, want 1", count)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	reopenedStore, err := store.NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore (reopen): %v", err)
+	}
+	reopened, err := NewPersistentStudentManager(reopenedStore)
+	if err != nil {
+		t.Fatalf("NewPersistentStudentManager (reopen): %v", err)
+	}
+
+	students := reopened.GetStudents()
+	if len(students) != 1 || students[0].ID != "A1" {
+		t.Fatalf("GetStudents after reopen = %+v, want one student A1", students)
+	}
+
+	if count, _ := reopened.LoginAttempts("A1"); count != 1 {
+		t.Fatalf("LoginAttempts after reopen = %d, want 1 (persisted across restart)", count)
+	}
+
+	// UnlockStudent is the admin recovery path; it should clear both the
+	// in-process backoff and the persisted counter so a login right after
+	// isn't still blocked by a backoff window seeded from the old count.
+	if err := reopened.UnlockStudent("A1"); err != nil {
+		t.Fatalf("UnlockStudent: %v", err)
+	}
+	if _, err := reopened.Login("A1", "Budi"); err != nil {
+		t.Fatalf("Login with correct name after unlock: %v", err)
+	}
+	if count, _ := reopened.LoginAttempts("A1"); count != 0 {
+		t.Fatalf("LoginAttempts after a successful login = %d, want 0", count)
+	}
+}
+
+// TestPersistentStudentManagerImportStudentsPersistsEveryRow checks that
+// every row from every file passed to ImportStudents lands in the store and
+// survives a restart. It does not tell a transactional write apart from
+// separate PutStudent calls.
+func TestPersistentStudentManagerImportStudentsPersistsEveryRow(t *testing.T) {
+	dir := t.TempDir()
+	file1 := filepath.Join(dir, "students1.csv")
+	if err := os.WriteFile(file1, []byte("A1,Budi,TI\nB2,Dito,TK\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	file2 := filepath.Join(dir, "students2.csv")
+	if err := os.WriteFile(file2, []byte("C3,Citra,MI\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	storePath := filepath.Join(dir, "students.json")
+	fs, err := store.NewFileStore(storePath)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	sm, err := NewPersistentStudentManager(fs)
+	if err != nil {
+		t.Fatalf("NewPersistentStudentManager: %v", err)
+	}
+
+	if err := sm.ImportStudents([]string{file1, file2}); err != nil {
+		t.Fatalf("ImportStudents: %v", err)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	reopenedStore, err := store.NewFileStore(storePath)
+	if err != nil {
+		t.Fatalf("NewFileStore (reopen): %v", err)
+	}
+	reopened, err := NewPersistentStudentManager(reopenedStore)
+	if err != nil {
+		t.Fatalf("NewPersistentStudentManager (reopen): %v", err)
+	}
+
+	students := reopened.GetStudents()
+	if len(students) != 3 {
+		t.Fatalf("GetStudents after reopen = %+v, want 3 imported students", students)
+	}
+}
+
+func TestPersistentStudentManagerEnableAuditRecordsEvents(t *testing.T) {
+	fs, err := store.NewFileStore(filepath.Join(t.TempDir(), "students.json"))
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer fs.Close()
+	sm, err := NewPersistentStudentManager(fs)
+	if err != nil {
+		t.Fatalf("NewPersistentStudentManager: %v", err)
+	}
+
+	var buf bytes.Buffer
+	sm.EnableAudit(audit.NewLogger(&buf))
+
+	if _, err := sm.Register("A1", "Budi", "TI"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), `"action":"register"`) {
+		t.Errorf("audit log = %q, want a register event", buf.String())
+	}
+}
+
+func TestPersistentStudentManagerGlobalLoginLimiter(t *testing.T) {
+	fs, err := store.NewFileStore(filepath.Join(t.TempDir(), "students.json"))
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer fs.Close()
+	sm, err := NewPersistentStudentManager(fs)
+	if err != nil {
+		t.Fatalf("NewPersistentStudentManager: %v", err)
+	}
+
+	sm.SetGlobalLoginLimiter(NewTokenBucket(0, 0))
+
+	if _, err := sm.Login("A1", "Budi"); err == nil {
+		t.Fatal("Login with an exhausted global limiter should fail")
+	}
+}
@@ -0,0 +1,63 @@
+package studentmgr
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// progressBar is a minimal stderr progress indicator redrawn in place with
+// carriage returns, with no external dependency.
+type progressBar struct {
+	mu    sync.Mutex
+	total int
+	done  int
+	out   io.Writer
+}
+
+func newProgressBar(total int, out io.Writer) *progressBar {
+	return &progressBar{total: total, out: out}
+}
+
+func (b *progressBar) Increment() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done++
+	b.render()
+}
+
+func (b *progressBar) Finish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.done = b.total
+	b.render()
+	fmt.Fprintln(b.out)
+}
+
+func (b *progressBar) render() {
+	const width = 30
+	filled := 0
+	if b.total > 0 {
+		filled = width * b.done / b.total
+	}
+	bar := make([]byte, width)
+	for i := range bar {
+		if i < filled {
+			bar[i] = '='
+		} else {
+			bar[i] = ' '
+		}
+	}
+	fmt.Fprintf(b.out, "\r[%s] %d/%d", bar, b.done, b.total)
+}
+
+// isTerminal reports whether f looks like an interactive terminal rather
+// than a pipe or a file.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
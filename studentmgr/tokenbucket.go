@@ -0,0 +1,50 @@
+package studentmgr
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket is a global rate limiter. Used alongside LoginLimiter it caps
+// the total rate of login attempts across all IDs, which per-ID backoff
+// alone doesn't: a brute-force attempt that spreads guesses across many IDs
+// never trips any single ID's limiter.
+type TokenBucket struct {
+	mu         sync.Mutex
+	capacity   float64
+	tokens     float64
+	refillRate float64 // tokens per second
+	now        func() time.Time
+	lastRefill time.Time
+}
+
+// NewTokenBucket returns a bucket holding at most capacity tokens that
+// refills at refillRate tokens per second.
+func NewTokenBucket(capacity, refillRate float64) *TokenBucket {
+	return &TokenBucket{
+		capacity:   capacity,
+		tokens:     capacity,
+		refillRate: refillRate,
+		now:        time.Now,
+		lastRefill: time.Now(),
+	}
+}
+
+// Allow consumes one token and reports whether one was available.
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens += now.Sub(b.lastRefill).Seconds() * b.refillRate
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.lastRefill = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,64 @@
+package studentmgr
+
+import (
+	"testing"
+	"time"
+)
+
+func newFakeTokenBucket(clock *fakeClock, capacity, refillRate float64) *TokenBucket {
+	b := &TokenBucket{
+		capacity:   capacity,
+		tokens:     capacity,
+		refillRate: refillRate,
+		now:        clock.now,
+		lastRefill: clock.now(),
+	}
+	return b
+}
+
+func TestTokenBucketAllowsUpToCapacity(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	b := newFakeTokenBucket(clock, 3, 1)
+
+	for i := 0; i < 3; i++ {
+		if !b.Allow() {
+			t.Fatalf("Allow() call %d should succeed within capacity", i+1)
+		}
+	}
+	if b.Allow() {
+		t.Fatal("Allow() after exhausting capacity should fail")
+	}
+}
+
+func TestTokenBucketRefillsOverTime(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	b := newFakeTokenBucket(clock, 1, 1)
+
+	if !b.Allow() {
+		t.Fatal("first Allow() should succeed")
+	}
+	if b.Allow() {
+		t.Fatal("Allow() with no elapsed time should fail, bucket is empty")
+	}
+
+	clock.advance(time.Second)
+	if !b.Allow() {
+		t.Fatal("Allow() after refillRate*1s worth of time should succeed")
+	}
+}
+
+func TestTokenBucketDoesNotExceedCapacity(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	b := newFakeTokenBucket(clock, 2, 10)
+
+	clock.advance(time.Minute)
+	allowed := 0
+	for i := 0; i < 5; i++ {
+		if b.Allow() {
+			allowed++
+		}
+	}
+	if allowed != 2 {
+		t.Errorf("after a long idle period, Allow() should succeed capacity (2) times, got %d", allowed)
+	}
+}